@@ -0,0 +1,133 @@
+package orderedmap
+
+import "sync"
+
+// A ConcurrentOrderedMap wraps an OrderedMap with a sync.RWMutex so it can
+// be safely shared across goroutines. Reads take the read lock and
+// mutations take the write lock.
+type ConcurrentOrderedMap struct {
+	mu sync.RWMutex
+	om *OrderedMap
+}
+
+// NewConcurrent creates a new, empty ConcurrentOrderedMap.
+func NewConcurrent() *ConcurrentOrderedMap {
+	return &ConcurrentOrderedMap{om: New()}
+}
+
+// Get returns the value of the map based on its key.
+// It will return nil if it doesn't exist.
+func (com *ConcurrentOrderedMap) Get(key string) (interface{}, bool) {
+	com.mu.RLock()
+	defer com.mu.RUnlock()
+	return com.om.Get(key)
+}
+
+// GetAt returns the value based on the provided pos.
+func (com *ConcurrentOrderedMap) GetAt(pos int) (interface{}, bool) {
+	com.mu.RLock()
+	defer com.mu.RUnlock()
+	return com.om.GetAt(pos)
+}
+
+// Set sets the key/value of the map based on key and value.
+// If the value already exists, the value will be replaced.
+func (com *ConcurrentOrderedMap) Set(key string, value interface{}) {
+	com.mu.Lock()
+	defer com.mu.Unlock()
+	com.om.Set(key, value)
+}
+
+// LoadOrStore returns the existing value for the key if present.
+// Otherwise, it stores and returns the given value. The loaded result is
+// true if the value was loaded, false if stored, mirroring sync.Map.
+func (com *ConcurrentOrderedMap) LoadOrStore(key string, value interface{}) (actual interface{}, loaded bool) {
+	com.mu.Lock()
+	defer com.mu.Unlock()
+
+	if actual, loaded = com.om.Get(key); loaded {
+		return actual, true
+	}
+	com.om.Set(key, value)
+	return value, false
+}
+
+// Delete remove an item from the map by the supplied key.
+// If the key does not exist, nothing happens.
+func (com *ConcurrentOrderedMap) Delete(key string) {
+	com.mu.Lock()
+	defer com.mu.Unlock()
+	com.om.Delete(key)
+}
+
+// DeleteAt delete the key/value pair from the map by the supplied offset.
+// If the offset is outside the range of the ordered map, nothing happens.
+func (com *ConcurrentOrderedMap) DeleteAt(offset int) {
+	com.mu.Lock()
+	defer com.mu.Unlock()
+	com.om.DeleteAt(offset)
+}
+
+// Keys return the keys of the map in the order they were added.
+func (com *ConcurrentOrderedMap) Keys() []string {
+	com.mu.RLock()
+	defer com.mu.RUnlock()
+
+	keys := make([]string, len(com.om.keys))
+	copy(keys, com.om.keys)
+	return keys
+}
+
+// Values returns a slice of the values in the order they were added.
+func (com *ConcurrentOrderedMap) Values() []interface{} {
+	com.mu.RLock()
+	defer com.mu.RUnlock()
+	return com.om.Values()
+}
+
+// Exists test whether the key exists or not.
+func (com *ConcurrentOrderedMap) Exists(key string) bool {
+	com.mu.RLock()
+	defer com.mu.RUnlock()
+	return com.om.Exists(key)
+}
+
+// Index returns the offset of the key in the ordered map.
+// If the key could not be found, -1 is returned.
+func (com *ConcurrentOrderedMap) Index(key string) int {
+	com.mu.RLock()
+	defer com.mu.RUnlock()
+	return com.om.Index(key)
+}
+
+// Len returns the number of key/value pairs in the map.
+func (com *ConcurrentOrderedMap) Len() int {
+	com.mu.RLock()
+	defer com.mu.RUnlock()
+	return com.om.Len()
+}
+
+// String returns the JSON serialized string representation.
+func (com *ConcurrentOrderedMap) String() string {
+	com.mu.RLock()
+	defer com.mu.RUnlock()
+	return com.om.String()
+}
+
+// MarshalJSON implements the json.Marshaller interface, so it could be serialized.
+func (com *ConcurrentOrderedMap) MarshalJSON() ([]byte, error) {
+	com.mu.RLock()
+	defer com.mu.RUnlock()
+	return com.om.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface.
+func (com *ConcurrentOrderedMap) UnmarshalJSON(b []byte) error {
+	com.mu.Lock()
+	defer com.mu.Unlock()
+
+	if com.om == nil {
+		com.om = New()
+	}
+	return com.om.UnmarshalJSON(b)
+}
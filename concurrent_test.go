@@ -0,0 +1,71 @@
+package orderedmap
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestConcurrentSetGet(t *testing.T) {
+	com := NewConcurrent()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := fmt.Sprintf("key%d", i)
+			com.Set(key, i)
+			v, ok := com.Get(key)
+			if !ok || v != i {
+				t.Errorf("Get(%q) = (%v, %v), want (%d, true)", key, v, ok, i)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if com.Len() != 100 {
+		t.Fatalf("Len() = %d, want 100", com.Len())
+	}
+}
+
+func TestConcurrentLoadOrStoreOnlyStoresOnce(t *testing.T) {
+	com := NewConcurrent()
+
+	var stores int64
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, loaded := com.LoadOrStore("shared", "first")
+			if !loaded {
+				atomic.AddInt64(&stores, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if stores != 1 {
+		t.Fatalf("LoadOrStore stored %d times concurrently, want 1", stores)
+	}
+
+	v, ok := com.Get("shared")
+	if !ok || v != "first" {
+		t.Fatalf("Get(\"shared\") = (%v, %v), want (\"first\", true)", v, ok)
+	}
+}
+
+func TestConcurrentLoadOrStoreReturnsExistingValue(t *testing.T) {
+	com := NewConcurrent()
+	com.Set("key", "original")
+
+	actual, loaded := com.LoadOrStore("key", "replacement")
+	if !loaded {
+		t.Fatalf("LoadOrStore() loaded = false, want true for existing key")
+	}
+	if actual != "original" {
+		t.Fatalf("LoadOrStore() actual = %v, want %q", actual, "original")
+	}
+}
@@ -0,0 +1,277 @@
+package orderedmap
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+// A Map is a generic, type-safe ordered map where the keys keep the order
+// that they're added. It behaves like OrderedMap but avoids the interface{}
+// type assertions callers otherwise need, since both the key and value
+// types are fixed at compile time.
+// The Map is not safe for concurrent use.
+type Map[K comparable, V any] struct {
+	// for preserving the order of key
+	keys   []K
+	values map[K]V
+}
+
+// NewGeneric creates a new, empty generic Map.
+func NewGeneric[K comparable, V any]() *Map[K, V] {
+	return &Map[K, V]{keys: []K{}, values: make(map[K]V)}
+}
+
+// Get returns the value of the map based on its key.
+// It will return the zero value of V if it doesn't exist.
+func (m *Map[K, V]) Get(key K) (val V, ok bool) {
+	val, ok = m.values[key]
+	return val, ok
+}
+
+// GetAt returns the value based on the provided pos.
+func (m *Map[K, V]) GetAt(pos int) (val V, ok bool) {
+	if pos < 0 || pos >= len(m.keys) {
+		return val, false
+	}
+	val, ok = m.values[m.keys[pos]]
+	return val, ok
+}
+
+// Set sets the key/value of the map based on key and value.
+// If the value already exists, the value will be replaced.
+func (m *Map[K, V]) Set(key K, value V) {
+	_, exists := m.values[key]
+	if !exists { //not exists
+		//add it to the keys array
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+// Delete remove an item from the map by the supplied key.
+// If the key does not exist, nothing happens.
+func (m *Map[K, V]) Delete(key K) {
+	_, ok := m.values[key]
+	if !ok { // key not exists, do nothing.
+		return
+	}
+
+	// remove from keys
+	for i, k := range m.keys {
+		if k == key {
+			m.keys = append(m.keys[:i], m.keys[i+1:]...)
+			break
+		}
+	}
+	// remove from values
+	delete(m.values, key)
+}
+
+// DeleteAt delete the key/value pair from the map by the supplied offset.
+// If the offset is outside the range of the ordered map, nothing happens.
+func (m *Map[K, V]) DeleteAt(offset int) {
+	if offset < 0 || offset >= len(m.keys) {
+		return
+	}
+	m.Delete(m.keys[offset])
+}
+
+// Keys return the keys of the map in the order they were added.
+func (m *Map[K, V]) Keys() []K {
+	return m.keys
+}
+
+// Values returns a slice of the values in the order they were added.
+func (m *Map[K, V]) Values() []V {
+	vals := make([]V, len(m.keys))
+
+	for idx, k := range m.keys {
+		vals[idx] = m.values[k]
+	}
+
+	return vals
+}
+
+// Exists test whether the key exists or not.
+func (m *Map[K, V]) Exists(key K) bool {
+	_, ok := m.values[key]
+	return ok
+}
+
+// Index returns the offset of the key in the ordered map.
+// If the key could not be found, -1 is returned.
+func (m *Map[K, V]) Index(key K) int {
+	for idx, k := range m.keys {
+		if k == key {
+			return idx
+		}
+	}
+	return -1
+}
+
+// SetAt sets the given key to the given value at the specified index.
+//
+// Deprecated: SetAt's negative-index handling is inconsistent, mirroring
+// the same issue in OrderedMap.SetAt; there is no generic equivalent of
+// InsertAt/MoveAt yet, so callers needing well-defined positional
+// semantics should fall back to the non-generic OrderedMap for now.
+func (m *Map[K, V]) SetAt(index int, key K, val V) {
+	valLen := len(m.values)
+	if index == -1 || index >= valLen {
+		m.Set(key, val)
+		return
+	}
+
+	if _, ok := m.values[key]; !ok { //if key not exists
+		if index < -valLen {
+			index = 0 // set at the begining
+		}
+		if index < 0 {
+			index = valLen + index + 1
+		}
+
+		var zero K
+		m.keys = append(m.keys, zero)          //assume the key is empty
+		copy(m.keys[index+1:], m.keys[index:]) //shift the keys array
+		m.keys[index] = key                    // reassign the key.
+	}
+	m.values[key] = val
+}
+
+func (m *Map[K, V]) Len() int {
+	return len(m.keys)
+}
+
+// String returns the JSON serialized string representation.
+func (m *Map[K, V]) String() string {
+	b, _ := m.MarshalJSON()
+	return string(b)
+}
+
+// MarshalJSON implements the json.Marshaller interface, so it could be serialized.
+// When serializing, the keys of the map will keep the order they are added.
+// The key type K must be string, or implement encoding.TextMarshaler, otherwise
+// MarshalJSON returns an error.
+func (m Map[K, V]) MarshalJSON() ([]byte, error) {
+	var out bytes.Buffer
+
+	out.WriteString("{")
+
+	for idx, key := range m.keys {
+		if idx > 0 {
+			out.WriteString(",")
+		}
+
+		keyStr, err := marshalMapKey(key)
+		if err != nil {
+			return []byte{}, err
+		}
+		b, err := json.Marshal(keyStr)
+		if err != nil {
+			return []byte{}, err
+		}
+		out.Write(b)
+
+		out.WriteString(":")
+
+		//marshal the value
+		b, err = json.Marshal(m.values[key])
+		if err != nil {
+			return []byte{}, err
+		}
+		out.Write(b)
+	} //end for
+
+	out.WriteString("}")
+	return out.Bytes(), nil
+}
+
+// marshalMapKey converts a map key to its string form for JSON object keys.
+func marshalMapKey[K comparable](key K) (string, error) {
+	switch k := any(key).(type) {
+	case string:
+		return k, nil
+	case encoding.TextMarshaler:
+		b, err := k.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	default:
+		return "", fmt.Errorf("orderedmap: key type %T is not string and does not implement encoding.TextMarshaler", key)
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface.
+// It only supports K being string (or implementing encoding.TextUnmarshaler)
+// since JSON object keys are always strings.
+func (m *Map[K, V]) UnmarshalJSON(b []byte) error {
+	in := bytes.TrimSpace(b)
+	dec := json.NewDecoder(bytes.NewReader(in))
+
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	// must open with a delim token '{'
+	if delim, ok := t.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("expect JSON object open with '{'")
+	}
+
+	if m.values == nil {
+		m.values = make(map[K]V)
+	}
+
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		keyStr, ok := t.(string)
+		if !ok {
+			return fmt.Errorf("key must be a string, got %T\n", t)
+		}
+
+		key, err := unmarshalMapKey[K](keyStr)
+		if err != nil {
+			return err
+		}
+
+		var val V
+		if err := dec.Decode(&val); err != nil {
+			return err
+		}
+		m.Set(key, val)
+	}
+
+	t, err = dec.Token() // '}'
+	if err != nil {
+		return err
+	}
+	if delim, ok := t.(json.Delim); !ok || delim != '}' {
+		return fmt.Errorf("expect JSON object close with '}'")
+	}
+
+	return nil
+}
+
+// unmarshalMapKey converts a decoded JSON object key back into K.
+func unmarshalMapKey[K comparable](keyStr string) (K, error) {
+	var key K
+	switch kp := any(&key).(type) {
+	case *string:
+		*kp = keyStr
+		return key, nil
+	case encoding.TextUnmarshaler:
+		if err := kp.UnmarshalText([]byte(keyStr)); err != nil {
+			return key, err
+		}
+		return key, nil
+	default:
+		return key, fmt.Errorf("orderedmap: key type %T is not string and does not implement encoding.TextUnmarshaler", key)
+	}
+}
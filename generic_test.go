@@ -0,0 +1,79 @@
+package orderedmap
+
+import "testing"
+
+func TestGenericSetGetKeysOrder(t *testing.T) {
+	m := NewGeneric[string, int]()
+	m.Set("b", 2)
+	m.Set("a", 1)
+	m.Set("b", 20) // update, should not move position
+
+	if got, want := m.Keys(), []string{"b", "a"}; !equalStringSlices(got, want) {
+		t.Fatalf("Keys() = %v, want %v", got, want)
+	}
+
+	v, ok := m.Get("b")
+	if !ok || v != 20 {
+		t.Fatalf("Get(\"b\") = (%v, %v), want (20, true)", v, ok)
+	}
+
+	if _, ok := m.Get("missing"); ok {
+		t.Fatalf("Get(\"missing\") ok = true, want false")
+	}
+}
+
+func TestGenericDeleteAndIndex(t *testing.T) {
+	m := NewGeneric[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	m.Delete("b")
+
+	if m.Exists("b") {
+		t.Fatalf("Exists(\"b\") = true after Delete")
+	}
+	if idx := m.Index("c"); idx != 1 {
+		t.Fatalf("Index(\"c\") = %d, want 1", idx)
+	}
+	if m.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", m.Len())
+	}
+}
+
+func TestGenericMarshalUnmarshalJSON(t *testing.T) {
+	m := NewGeneric[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	b, err := m.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if got, want := string(b), `{"a":1,"b":2}`; got != want {
+		t.Fatalf("MarshalJSON() = %s, want %s", got, want)
+	}
+
+	var decoded Map[string, int]
+	if err := decoded.UnmarshalJSON(b); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if got, want := decoded.Keys(), []string{"a", "b"}; !equalStringSlices(got, want) {
+		t.Fatalf("UnmarshalJSON Keys() = %v, want %v", got, want)
+	}
+	if v, _ := decoded.Get("b"); v != 2 {
+		t.Fatalf("UnmarshalJSON Get(\"b\") = %v, want 2", v)
+	}
+}
+
+func equalStringSlices(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
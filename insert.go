@@ -0,0 +1,91 @@
+package orderedmap
+
+import "fmt"
+
+// InsertAt inserts key/value at position pos without overwriting any
+// existing entry, shifting subsequent entries down by one. If key already
+// exists in the map, its value is updated and it is left in its current
+// position, exactly like Set.
+//
+// pos is 0-based. A negative pos is an offset from the end, where -1 is
+// the last existing position. Positions range over [-n, n], where n is
+// the number of entries before insertion (n itself means "append"); an
+// out-of-range pos returns an error instead of silently appending.
+func (om *OrderedMap) InsertAt(pos int, key string, value interface{}) error {
+	if _, ok := om.values[key]; ok { //key already exists, behave like Set
+		om.values[key] = value
+		return nil
+	}
+
+	n := len(om.keys)
+	idx, err := resolveIndex(pos, n, n)
+	if err != nil {
+		return err
+	}
+
+	om.keys = append(om.keys, "")
+	copy(om.keys[idx+1:], om.keys[idx:])
+	om.keys[idx] = key
+
+	if om.values == nil {
+		om.values = make(map[string]interface{})
+	}
+	om.values[key] = value
+	return nil
+}
+
+// MoveAt repositions an existing key to pos, shifting the entries between
+// its old and new position accordingly. It returns an error if key does
+// not exist or pos is out of range.
+//
+// pos is 0-based. A negative pos is an offset from the end, where -1 is
+// the last position. Positions range over [-n, n-1], where n is the
+// number of entries in the map.
+func (om *OrderedMap) MoveAt(key string, pos int) error {
+	if _, ok := om.values[key]; !ok {
+		return fmt.Errorf("key %q does not exist", key)
+	}
+
+	n := len(om.keys)
+	idx, err := resolveIndex(pos, n, n-1)
+	if err != nil {
+		return err
+	}
+
+	from := om.Index(key)
+	if from == idx {
+		return nil
+	}
+
+	om.keys = append(om.keys[:from], om.keys[from+1:]...)
+	om.keys = append(om.keys, "")
+	copy(om.keys[idx+1:], om.keys[idx:])
+	om.keys[idx] = key
+	return nil
+}
+
+// Swap exchanges the positions of the entries at offsets i and j.
+// If either offset is outside the range of the map, nothing happens.
+func (om *OrderedMap) Swap(i, j int) {
+	if i < 0 || i >= len(om.keys) || j < 0 || j >= len(om.keys) {
+		return
+	}
+	om.keys[i], om.keys[j] = om.keys[j], om.keys[i]
+}
+
+// resolveIndex normalizes pos into a 0-based offset: non-negative pos must
+// be <= max, and negative pos counts back from the end of an n-length
+// map, where -1 resolves to n-1. It returns a descriptive error if pos
+// falls outside [-n, max].
+func resolveIndex(pos, n, max int) (int, error) {
+	if pos >= 0 {
+		if pos > max {
+			return 0, fmt.Errorf("position %d outside range [%d,%d]", pos, -n, max)
+		}
+		return pos, nil
+	}
+	if pos < -n {
+		return 0, fmt.Errorf("position %d outside range [%d,%d]", pos, -n, max)
+	}
+	return n + pos, nil
+}
@@ -0,0 +1,140 @@
+package orderedmap
+
+import "testing"
+
+func TestInsertAt(t *testing.T) {
+	tests := []struct {
+		name    string
+		pos     int
+		key     string
+		wantErr bool
+		want    []string
+	}{
+		{name: "append at length", pos: 3, key: "d", want: []string{"a", "b", "c", "d"}},
+		{name: "insert at front", pos: 0, key: "d", want: []string{"d", "a", "b", "c"}},
+		{name: "negative last position", pos: -1, key: "d", want: []string{"a", "b", "d", "c"}},
+		{name: "negative front", pos: -3, key: "d", want: []string{"d", "a", "b", "c"}},
+		{name: "positive out of range", pos: 4, key: "d", wantErr: true},
+		{name: "negative out of range", pos: -4, key: "d", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			om := New()
+			om.Set("a", 1)
+			om.Set("b", 2)
+			om.Set("c", 3)
+
+			err := om.InsertAt(tt.pos, tt.key, 4)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("InsertAt(%d) error = nil, want error", tt.pos)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("InsertAt(%d) error = %v", tt.pos, err)
+			}
+			if got := om.Keys(); !equalStringSlices(got, tt.want) {
+				t.Fatalf("Keys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInsertAtExistingKeyUpdatesInPlace(t *testing.T) {
+	om := New()
+	om.Set("a", 1)
+	om.Set("b", 2)
+
+	if err := om.InsertAt(0, "b", 20); err != nil {
+		t.Fatalf("InsertAt() error = %v", err)
+	}
+	if got, want := om.Keys(), []string{"a", "b"}; !equalStringSlices(got, want) {
+		t.Fatalf("Keys() = %v, want %v", got, want)
+	}
+	if v, _ := om.Get("b"); v != 20 {
+		t.Fatalf("Get(\"b\") = %v, want 20", v)
+	}
+}
+
+func TestMoveAt(t *testing.T) {
+	tests := []struct {
+		name    string
+		pos     int
+		wantErr bool
+		want    []string
+	}{
+		{name: "move to front", pos: 0, want: []string{"c", "a", "b"}},
+		{name: "negative last position", pos: -1, want: []string{"a", "b", "c"}},
+		{name: "no-op same position", pos: -1, want: []string{"a", "b", "c"}},
+		{name: "out of range", pos: 3, wantErr: true},
+		{name: "negative out of range", pos: -4, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			om := New()
+			om.Set("a", 1)
+			om.Set("b", 2)
+			om.Set("c", 3)
+
+			err := om.MoveAt("c", tt.pos)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("MoveAt(%d) error = nil, want error", tt.pos)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MoveAt(%d) error = %v", tt.pos, err)
+			}
+			if got := om.Keys(); !equalStringSlices(got, tt.want) {
+				t.Fatalf("Keys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoveAtMissingKey(t *testing.T) {
+	om := New()
+	om.Set("a", 1)
+
+	if err := om.MoveAt("missing", 0); err == nil {
+		t.Fatalf("MoveAt() error = nil, want error for missing key")
+	}
+}
+
+func TestSwap(t *testing.T) {
+	om := New()
+	om.Set("a", 1)
+	om.Set("b", 2)
+	om.Set("c", 3)
+
+	om.Swap(0, 2)
+	if got, want := om.Keys(), []string{"c", "b", "a"}; !equalStringSlices(got, want) {
+		t.Fatalf("Keys() = %v, want %v", got, want)
+	}
+
+	// out of range swap is a no-op
+	om.Swap(0, 5)
+	if got, want := om.Keys(), []string{"c", "b", "a"}; !equalStringSlices(got, want) {
+		t.Fatalf("Keys() after out-of-range Swap = %v, want %v", got, want)
+	}
+}
+
+func TestSetAtMinusOneAppends(t *testing.T) {
+	om := New()
+	om.Set("a", 1)
+	om.Set("b", 2)
+	om.Set("c", 3)
+
+	om.SetAt(-1, "d", 4)
+
+	if got, want := om.Keys(), []string{"a", "b", "c", "d"}; !equalStringSlices(got, want) {
+		t.Fatalf("Keys() = %v, want %v", got, want)
+	}
+	if v, _ := om.Get("d"); v != 4 {
+		t.Fatalf("Get(\"d\") = %v, want 4", v)
+	}
+}
@@ -0,0 +1,28 @@
+package orderedmap
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalOrderedJSON parses an arbitrary JSON document, preserving object
+// key order at every nesting depth. The root value may be a JSON object
+// (returned as *OrderedMap), an array (returned as []interface{}, with any
+// nested objects also as *OrderedMap), or a scalar, unlike UnmarshalJSON
+// which only accepts an object at the root.
+func UnmarshalOrderedJSON(b []byte) (interface{}, error) {
+	in := bytes.TrimSpace(b)
+	dec := json.NewDecoder(bytes.NewReader(in))
+
+	val, err := parseObject(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	if dec.More() {
+		return nil, fmt.Errorf("unexpected trailing data after JSON value")
+	}
+
+	return val, nil
+}
@@ -0,0 +1,55 @@
+package orderedmap
+
+import "testing"
+
+func TestUnmarshalOrderedJSONArrayOfObjectsPreservesKeyOrder(t *testing.T) {
+	val, err := UnmarshalOrderedJSON([]byte(`[{"b":1,"a":2},{"d":3,"c":4}]`))
+	if err != nil {
+		t.Fatalf("UnmarshalOrderedJSON() error = %v", err)
+	}
+
+	arr, ok := val.([]interface{})
+	if !ok || len(arr) != 2 {
+		t.Fatalf("UnmarshalOrderedJSON() = %#v, want []interface{} of length 2", val)
+	}
+
+	first, ok := arr[0].(*OrderedMap)
+	if !ok {
+		t.Fatalf("arr[0] = %#v, want *OrderedMap", arr[0])
+	}
+	if got, want := first.Keys(), []string{"b", "a"}; !equalStringSlices(got, want) {
+		t.Fatalf("arr[0].Keys() = %v, want %v", got, want)
+	}
+
+	second, ok := arr[1].(*OrderedMap)
+	if !ok {
+		t.Fatalf("arr[1] = %#v, want *OrderedMap", arr[1])
+	}
+	if got, want := second.Keys(), []string{"d", "c"}; !equalStringSlices(got, want) {
+		t.Fatalf("arr[1].Keys() = %v, want %v", got, want)
+	}
+}
+
+func TestUnmarshalOrderedJSONScalarRoot(t *testing.T) {
+	val, err := UnmarshalOrderedJSON([]byte(`42`))
+	if err != nil {
+		t.Fatalf("UnmarshalOrderedJSON() error = %v", err)
+	}
+	if val != float64(42) {
+		t.Fatalf("UnmarshalOrderedJSON() = %v, want 42", val)
+	}
+}
+
+func TestUnmarshalOrderedJSONTrailingData(t *testing.T) {
+	if _, err := UnmarshalOrderedJSON([]byte(`{"a":1} garbage`)); err == nil {
+		t.Fatalf("UnmarshalOrderedJSON() error = nil, want error for trailing data")
+	}
+}
+
+func TestUnmarshalJSONPropagatesInnerError(t *testing.T) {
+	om := New()
+	err := om.UnmarshalJSON([]byte(`{"a": }`))
+	if err == nil {
+		t.Fatalf("UnmarshalJSON() error = nil, want error for malformed value")
+	}
+}
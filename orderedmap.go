@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
-	"strings"
 	"fmt"
 )
 
@@ -17,11 +16,61 @@ type OrderedMap struct {
 	// for preserving the order of key
 	keys   []string
 	values map[string]interface{}
+
+	// escapeHTML controls whether MarshalJSON escapes '<', '>' and '&' in
+	// values, mirroring json.Encoder.SetEscapeHTML. Defaults to true, the
+	// same default as encoding/json.
+	escapeHTML bool
 }
 
-// New create a new OrderedMap.
-func New() *OrderedMap {
-	return &OrderedMap{keys:[]string{}, values:make(map[string]interface{})}
+// An Option configures an OrderedMap constructed by New.
+type Option func(*OrderedMap)
+
+// WithCapacity preallocates the keys and values storage for n entries,
+// avoiding repeated map/slice growth when building large maps. It copies
+// any entries already present into the new storage, so it is safe to
+// combine with WithInitialPairs regardless of option order.
+func WithCapacity(n int) Option {
+	return func(om *OrderedMap) {
+		if n < len(om.keys) {
+			n = len(om.keys)
+		}
+
+		keys := make([]string, len(om.keys), n)
+		copy(keys, om.keys)
+		om.keys = keys
+
+		values := make(map[string]interface{}, n)
+		for k, v := range om.values {
+			values[k] = v
+		}
+		om.values = values
+	}
+}
+
+// WithInitialPairs seeds the map with the given pairs, in order, as part
+// of construction, e.g. New(WithInitialPairs(NewPair("a", 1), NewPair("b", 2))).
+func WithInitialPairs(pairs ...Pair) Option {
+	return func(om *OrderedMap) {
+		om.AddPairs(pairs...)
+	}
+}
+
+// New create a new OrderedMap, applying any supplied options.
+func New(opts ...Option) *OrderedMap {
+	om := &OrderedMap{keys: []string{}, values: make(map[string]interface{}), escapeHTML: true}
+	for _, opt := range opts {
+		opt(om)
+	}
+	return om
+}
+
+// SetEscapeHTML specifies whether problematic HTML characters should be
+// escaped inside JSON quoted strings, mirroring json.Encoder.SetEscapeHTML.
+// The default behavior is to escape them for safety when embedding JSON in
+// HTML, as encoding/json does.
+func (om *OrderedMap) SetEscapeHTML(on bool) {
+	om.escapeHTML = on
 }
 
 // Get returns the value of the map based on its key.
@@ -56,11 +105,24 @@ func (om *OrderedMap) Set(key string, value interface{}) {
 	om.values[key] = value
 }
 
+// AddPairs inserts pairs in bulk, in order, equivalent to calling Set for
+// each one.
+func (om *OrderedMap) AddPairs(pairs ...Pair) {
+	for _, p := range pairs {
+		om.Set(p.key, p.value)
+	}
+}
+
 // SetAt sets the given key to the given value at the specified index.
+//
+// Deprecated: SetAt's negative-index handling is inconsistent; use
+// InsertAt or MoveAt instead, which document their index semantics and
+// report out-of-range positions as errors rather than silently appending.
 func (om *OrderedMap) SetAt(index int, key string, val interface{}) {
 	valLen := len(om.values)
 	if index == -1 || index >= valLen {
 		om.Set(key, val)
+		return
 	}
 
 	if om.values == nil {
@@ -159,33 +221,58 @@ func (om *OrderedMap) String() string {
 
 // MarshalJSON implements the json.Marshaller interface, so it could be serialized.
 // When serializing, the keys of the map will keep the order they are added.
+// Whether HTML characters in values are escaped is controlled by SetEscapeHTML.
+// HTML characters inside a nested *OrderedMap value are escaped if either
+// om's setting or the nested map's own setting is on.
 func (om OrderedMap) MarshalJSON() ([]byte, error) {
+	return om.marshalJSON(om.escapeHTML)
+}
+
+// marshalJSON serializes om to JSON using the given HTML-escape setting,
+// independent of om.escapeHTML. It lets callers such as Encoder apply
+// their own escaping policy without mutating the map.
+func (om OrderedMap) marshalJSON(escapeHTML bool) ([]byte, error) {
 	var out bytes.Buffer
 
 	out.WriteString("{")
 
-	for idx, key:= range om.keys {
+	for idx, key := range om.keys {
 		if idx > 0 {
 			out.WriteString(",")
 		}
 
-		esc := strings.Replace(key, `"`, `\"`, -1)
-		out.WriteString(`"` + esc + `"`)
+		keyJSON, err := encode(key, escapeHTML)
+		if err != nil {
+			return []byte{}, err
+		}
+		out.Write(keyJSON)
 
 		out.WriteString(":")
 
 		//marshal the value
-		b, err := json.Marshal(om.values[key])
+		b, err := encode(om.values[key], escapeHTML)
 		if err != nil {
 			return []byte{}, err
 		}
-		out.WriteString(string(b))
+		out.Write(b)
 	} //end for
 
 	out.WriteString("}")
 	return out.Bytes(), nil
 }
 
+// encode marshals v to JSON through a json.Encoder configured with the
+// given HTML-escape setting, trimming the trailing newline Encode adds.
+func encode(v interface{}, escapeHTML bool) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(escapeHTML)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
 // UnmarshalJSON implements the json.Unmarshaller interface.
 // so it could be use like below:
 //      o := New()
@@ -194,7 +281,13 @@ func (om *OrderedMap) UnmarshalJSON(b []byte) error {
 	//Using Decoder to parse the bytes.
 	in := bytes.TrimSpace(b)
 	dec := json.NewDecoder(bytes.NewReader(in))
+	return om.decodeFrom(dec)
+}
 
+// decodeFrom reads a single JSON object off dec into om, preserving key
+// order. It is shared by UnmarshalJSON, which reads from an in-memory
+// byte slice, and Decoder.Decode, which streams from an io.Reader.
+func (om *OrderedMap) decodeFrom(dec *json.Decoder) error {
 	t, err := dec.Token()
 	if err != nil {
 		return err
@@ -205,7 +298,9 @@ func (om *OrderedMap) UnmarshalJSON(b []byte) error {
 		return fmt.Errorf("expect JSON object open with '{'")
 	}
 
-	om.unmarshalJSON(dec)
+	if err := om.unmarshalJSON(dec); err != nil {
+		return err
+	}
 
 	t, err = dec.Token() //'}'
 	if err != nil {
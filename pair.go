@@ -0,0 +1,74 @@
+package orderedmap
+
+import "sort"
+
+// A Pair holds a single key/value entry of an OrderedMap.
+type Pair struct {
+	key   string
+	value interface{}
+}
+
+// NewPair creates a Pair, for use with WithInitialPairs and AddPairs.
+func NewPair(key string, value interface{}) Pair {
+	return Pair{key: key, value: value}
+}
+
+// Key returns the key of the pair.
+func (p *Pair) Key() string {
+	return p.key
+}
+
+// Value returns the value of the pair.
+func (p *Pair) Value() interface{} {
+	return p.value
+}
+
+// Sort reorders the map in place according to the less function, which
+// reports whether the pair at a should sort before the pair at b.
+func (om *OrderedMap) Sort(less func(a, b *Pair) bool) {
+	pairs := make([]*Pair, len(om.keys))
+	for idx, key := range om.keys {
+		pairs[idx] = &Pair{key: key, value: om.values[key]}
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool { return less(pairs[i], pairs[j]) })
+
+	keys := make([]string, len(pairs))
+	for idx, p := range pairs {
+		keys[idx] = p.key
+	}
+	om.keys = keys
+}
+
+// An Iterator walks the entries of an OrderedMap in order, without
+// allocating the full Keys()/Values() slices up front.
+type Iterator struct {
+	om  *OrderedMap
+	pos int
+}
+
+// Iter returns an Iterator positioned before the first entry of the map.
+func (om *OrderedMap) Iter() *Iterator {
+	return &Iterator{om: om}
+}
+
+// Next advances the iterator and returns the next Pair.
+// The second return value is false once there are no more entries.
+func (it *Iterator) Next() (*Pair, bool) {
+	if it.pos >= len(it.om.keys) {
+		return nil, false
+	}
+	key := it.om.keys[it.pos]
+	it.pos++
+	return &Pair{key: key, value: it.om.values[key]}, true
+}
+
+// All is a Go 1.23 range-over-func iterator over the map's key/value
+// pairs in order, used as a method value, e.g. `for k, v := range om.All { ... }`.
+func (om *OrderedMap) All(yield func(key string, value interface{}) bool) {
+	for _, key := range om.keys {
+		if !yield(key, om.values[key]) {
+			return
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package orderedmap
+
+import "testing"
+
+func TestSortOrdersByValue(t *testing.T) {
+	om := New()
+	om.Set("a", 3)
+	om.Set("b", 1)
+	om.Set("c", 2)
+
+	om.Sort(func(a, b *Pair) bool {
+		return a.Value().(int) < b.Value().(int)
+	})
+
+	if got, want := om.Keys(), []string{"b", "c", "a"}; !equalStringSlices(got, want) {
+		t.Fatalf("Keys() after Sort = %v, want %v", got, want)
+	}
+}
+
+func TestSortIsStableForEqualElements(t *testing.T) {
+	om := New()
+	om.Set("a", 1)
+	om.Set("b", 1)
+	om.Set("c", 1)
+
+	om.Sort(func(a, b *Pair) bool { return false }) // every pair compares equal
+
+	if got, want := om.Keys(), []string{"a", "b", "c"}; !equalStringSlices(got, want) {
+		t.Fatalf("Keys() after stable Sort = %v, want %v", got, want)
+	}
+}
+
+func TestIterVisitsAllPairsInOrder(t *testing.T) {
+	om := New()
+	om.Set("a", 1)
+	om.Set("b", 2)
+	om.Set("c", 3)
+
+	it := om.Iter()
+	var keys []string
+	var values []interface{}
+	for {
+		p, ok := it.Next()
+		if !ok {
+			break
+		}
+		keys = append(keys, p.Key())
+		values = append(values, p.Value())
+	}
+
+	if want := []string{"a", "b", "c"}; !equalStringSlices(keys, want) {
+		t.Fatalf("iterated keys = %v, want %v", keys, want)
+	}
+	if len(values) != 3 || values[0] != 1 || values[1] != 2 || values[2] != 3 {
+		t.Fatalf("iterated values = %v, want [1 2 3]", values)
+	}
+}
+
+// These call All's yield function directly rather than via a range
+// statement, since range-over-func requires a go1.23+ toolchain; the
+// behavior exercised is identical to what `for k, v := range om.All`
+// would do once that's available.
+func TestAllYieldsPairsInOrder(t *testing.T) {
+	om := New()
+	om.Set("a", 1)
+	om.Set("b", 2)
+
+	var keys []string
+	om.All(func(k string, v interface{}) bool {
+		keys = append(keys, k)
+		if om.values[k] != v {
+			t.Fatalf("All() yielded value %v for key %q, want %v", v, k, om.values[k])
+		}
+		return true
+	})
+
+	if want := []string{"a", "b"}; !equalStringSlices(keys, want) {
+		t.Fatalf("All() keys = %v, want %v", keys, want)
+	}
+}
+
+func TestAllStopsWhenYieldReturnsFalse(t *testing.T) {
+	om := New()
+	om.Set("a", 1)
+	om.Set("b", 2)
+	om.Set("c", 3)
+
+	var keys []string
+	om.All(func(k string, v interface{}) bool {
+		keys = append(keys, k)
+		return k != "b"
+	})
+
+	if want := []string{"a", "b"}; !equalStringSlices(keys, want) {
+		t.Fatalf("All() early-stop keys = %v, want %v", keys, want)
+	}
+}
@@ -0,0 +1,65 @@
+package orderedmap
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// An Encoder writes OrderedMap values to an output stream, one JSON
+// object per line. Each call to Encode builds the complete encoding of
+// the map in memory, as MarshalJSON does, before writing it to the stream.
+type Encoder struct {
+	w          io.Writer
+	escapeHTML bool
+}
+
+// NewEncoder returns a new Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w, escapeHTML: true}
+}
+
+// SetEscapeHTML specifies whether problematic HTML characters should be
+// escaped, mirroring json.Encoder.SetEscapeHTML. It applies to every
+// subsequent call to Encode. HTML characters inside a nested *OrderedMap
+// value are escaped if either this setting or the nested map's own
+// setting is on.
+func (enc *Encoder) SetEscapeHTML(on bool) {
+	enc.escapeHTML = on
+}
+
+// Encode writes the JSON encoding of om to the stream, followed by a
+// newline. The encoding honors the Encoder's own SetEscapeHTML setting,
+// independent of om's, and never modifies om.
+func (enc *Encoder) Encode(om *OrderedMap) error {
+	b, err := om.marshalJSON(enc.escapeHTML)
+	if err != nil {
+		return err
+	}
+
+	if _, err := enc.w.Write(b); err != nil {
+		return err
+	}
+	_, err = enc.w.Write([]byte("\n"))
+	return err
+}
+
+// A Decoder reads OrderedMap values from an input stream, preserving key
+// order, without buffering the whole document in memory the way
+// UnmarshalJSON does.
+type Decoder struct {
+	dec *json.Decoder
+}
+
+// NewDecoder returns a new Decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{dec: json.NewDecoder(r)}
+}
+
+// Decode reads the next JSON-encoded object from the stream and stores it
+// in om, preserving key order.
+func (d *Decoder) Decode(om *OrderedMap) error {
+	if om.values == nil {
+		*om = *New()
+	}
+	return om.decodeFrom(d.dec)
+}
@@ -0,0 +1,85 @@
+package orderedmap
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestEncoderDecoderRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+
+	for _, pair := range []Pair{NewPair("a", 1.0), NewPair("b", 2.0)} {
+		om := New()
+		om.Set(pair.Key(), pair.Value())
+		if err := enc.Encode(om); err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+	}
+
+	dec := NewDecoder(&buf)
+	for _, want := range []string{"a", "b"} {
+		var om OrderedMap
+		if err := dec.Decode(&om); err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		if !om.Exists(want) {
+			t.Fatalf("Decode() = %v, want key %q", om.Keys(), want)
+		}
+	}
+
+	var om OrderedMap
+	if err := dec.Decode(&om); err != io.EOF {
+		t.Fatalf("Decode() at end error = %v, want io.EOF", err)
+	}
+}
+
+func TestEncoderDoesNotMutateSourceMap(t *testing.T) {
+	om := New()
+	om.SetEscapeHTML(false)
+	om.Set("a", "<b>")
+
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	enc.SetEscapeHTML(true)
+	if err := enc.Encode(om); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	if strings.Contains(buf.String(), `<b>`) {
+		t.Fatalf("Encode() output = %s, want HTML-escaped per Encoder setting (no literal <b>)", buf.String())
+	}
+
+	// om's own setting must be untouched by the Encoder.
+	b, err := om.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if !strings.Contains(string(b), "<b>") {
+		t.Fatalf("MarshalJSON() = %s, want unescaped per om's own SetEscapeHTML(false)", b)
+	}
+}
+
+func TestSetEscapeHTML(t *testing.T) {
+	om := New()
+	om.Set("a", "<b>")
+
+	escaped, err := om.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if strings.Contains(string(escaped), `<b>`) {
+		t.Fatalf("MarshalJSON() default = %s, want HTML-escaped (no literal <b>)", escaped)
+	}
+
+	om.SetEscapeHTML(false)
+	unescaped, err := om.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if !strings.Contains(string(unescaped), "<b>") {
+		t.Fatalf("MarshalJSON() with SetEscapeHTML(false) = %s, want unescaped", unescaped)
+	}
+}
@@ -0,0 +1,94 @@
+package orderedmap
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalYAML implements the yaml.Marshaler interface, so it could be
+// serialized by gopkg.in/yaml.v3. When serializing, the keys of the map
+// will keep the order they are added.
+func (om *OrderedMap) MarshalYAML() (interface{}, error) {
+	node := &yaml.Node{
+		Kind: yaml.MappingNode,
+		Tag:  "!!map",
+	}
+
+	for _, key := range om.keys {
+		keyNode := &yaml.Node{}
+		if err := keyNode.Encode(key); err != nil {
+			return nil, err
+		}
+
+		valNode := &yaml.Node{}
+		if err := valNode.Encode(om.values[key]); err != nil {
+			return nil, err
+		}
+
+		node.Content = append(node.Content, keyNode, valNode)
+	}
+
+	return node, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface, so OrderedMap
+// can be decoded from a YAML mapping, preserving key order. Nested
+// mappings decode as *OrderedMap and sequences as []interface{}, symmetric
+// to UnmarshalJSON.
+func (om *OrderedMap) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("expect a YAML mapping, got kind %v", node.Kind)
+	}
+
+	*om = *New()
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode := node.Content[i]
+		valNode := node.Content[i+1]
+
+		var key string
+		if err := keyNode.Decode(&key); err != nil {
+			return err
+		}
+
+		val, err := decodeYAMLNode(valNode)
+		if err != nil {
+			return err
+		}
+
+		om.Set(key, val)
+	}
+
+	return nil
+}
+
+// decodeYAMLNode decodes a single YAML node into a plain Go value,
+// recursing into mappings as *OrderedMap and sequences as []interface{},
+// mirroring parseObject/parseArray for JSON.
+func decodeYAMLNode(node *yaml.Node) (interface{}, error) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		om := New()
+		if err := om.UnmarshalYAML(node); err != nil {
+			return nil, err
+		}
+		return om, nil
+	case yaml.SequenceNode:
+		vals := make([]interface{}, 0, len(node.Content))
+		for _, item := range node.Content {
+			v, err := decodeYAMLNode(item)
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, v)
+		}
+		return vals, nil
+	default:
+		var v interface{}
+		if err := node.Decode(&v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
@@ -0,0 +1,79 @@
+package orderedmap
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestMarshalYAMLPreservesKeyOrder(t *testing.T) {
+	om := New()
+	om.Set("b", 1)
+	om.Set("a", 2)
+
+	b, err := yaml.Marshal(om)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	want := "b: 1\na: 2\n"
+	if string(b) != want {
+		t.Fatalf("yaml.Marshal() = %q, want %q", b, want)
+	}
+}
+
+func TestUnmarshalYAMLPreservesKeyOrderAndNesting(t *testing.T) {
+	doc := "b:\n  y: 1\n  x: 2\na:\n  - 1\n  - 2\n"
+
+	var om OrderedMap
+	if err := yaml.Unmarshal([]byte(doc), &om); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+
+	if got, want := om.Keys(), []string{"b", "a"}; !equalStringSlices(got, want) {
+		t.Fatalf("Keys() = %v, want %v", got, want)
+	}
+
+	nested, ok := om.values["b"].(*OrderedMap)
+	if !ok {
+		t.Fatalf("values[\"b\"] = %#v, want *OrderedMap", om.values["b"])
+	}
+	if got, want := nested.Keys(), []string{"y", "x"}; !equalStringSlices(got, want) {
+		t.Fatalf("nested Keys() = %v, want %v", got, want)
+	}
+
+	seq, ok := om.values["a"].([]interface{})
+	if !ok || len(seq) != 2 {
+		t.Fatalf("values[\"a\"] = %#v, want []interface{} of length 2", om.values["a"])
+	}
+}
+
+func TestUnmarshalYAMLRejectsNonMappingRoot(t *testing.T) {
+	var om OrderedMap
+	if err := yaml.Unmarshal([]byte("- 1\n- 2\n"), &om); err == nil {
+		t.Fatalf("yaml.Unmarshal() error = nil, want error for sequence root")
+	}
+}
+
+func TestYAMLRoundTrip(t *testing.T) {
+	om := New()
+	om.Set("name", "widget")
+	om.Set("count", 3)
+
+	b, err := yaml.Marshal(om)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+
+	var decoded OrderedMap
+	if err := yaml.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+
+	if got, want := decoded.Keys(), []string{"name", "count"}; !equalStringSlices(got, want) {
+		t.Fatalf("Keys() = %v, want %v", got, want)
+	}
+	if v, _ := decoded.Get("name"); v != "widget" {
+		t.Fatalf("Get(\"name\") = %v, want \"widget\"", v)
+	}
+}